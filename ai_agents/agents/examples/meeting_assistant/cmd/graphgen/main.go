@@ -0,0 +1,64 @@
+// Command graphgen renders and validates meeting graph templates without
+// starting the TEN app, so operators can CI-check their meeting configs.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/TEN-framework/ten_framework/ai_agents/agents/meetingassistant/graphgen"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "validate":
+		runValidate(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func runValidate(args []string) {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	specPath := fs.String("spec", "", "path to a JSON file containing a graphgen.Spec (reads stdin if omitted)")
+	fs.Parse(args)
+
+	raw, err := readSpec(*specPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "graphgen validate: %v\n", err)
+		os.Exit(1)
+	}
+
+	var spec graphgen.Spec
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		fmt.Fprintf(os.Stderr, "graphgen validate: parsing spec: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := graphgen.Validate(spec); err != nil {
+		fmt.Fprintf(os.Stderr, "graphgen validate: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("graphgen validate: template %q is valid\n", spec.Template)
+}
+
+func readSpec(path string) ([]byte, error) {
+	if path == "" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: graphgen validate [-spec path/to/spec.json]")
+}
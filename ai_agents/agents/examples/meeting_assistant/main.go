@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 
+	"github.com/TEN-framework/ten_framework/ai_agents/agents/meetingassistant"
 	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
 )
 
@@ -14,11 +15,15 @@ func main() {
 
 	// Customize the app.
 	tenApp.OnConfigure(func(tenApp ten_runtime_go.TenApp, configJson string) {
-		log.Printf("Meeting Assistant app configured with: %s", configJson)
+		expanded, err := meetingassistant.Configure(tenApp, configJson)
+		if err != nil {
+			log.Fatalf("Meeting Assistant failed to configure: %v", err)
+		}
+		log.Printf("Meeting Assistant app configured with: %s", expanded)
 	})
 
 	// Start the app.
 	tenApp.Run(false)
 
 	log.Println("Meeting Assistant stopped.")
-}
\ No newline at end of file
+}
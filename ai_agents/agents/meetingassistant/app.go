@@ -0,0 +1,77 @@
+// Package meetingassistant is the reusable meeting-assistant library: the
+// voice, session, and auth extensions plus the wiring to register them
+// against a TenApp. It is shared by the CLI entry point in
+// ai_agents/agents/examples/meeting_assistant and the gomobile bindings in
+// mobile/.
+package meetingassistant
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/TEN-framework/ten_framework/ai_agents/agents/meetingassistant/graphgen"
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+// authConfig is the shape of the "auth" section of the configJson passed to
+// Configure.
+type authConfig struct {
+	AppID         string `json:"app_id"`
+	PrivateKeyPEM string `json:"private_key_pem"`
+	StaticToken   string `json:"static_token"`
+}
+
+// Configure expands configJson (resolving a {"template": ..., "vars": {...}}
+// form via graphgen if present) and registers every meeting-assistant
+// extension against tenApp. It returns the expanded configJson so callers
+// can log or inspect what the app actually started with.
+func Configure(tenApp ten_runtime_go.TenApp, configJson string) (string, error) {
+	if graphgen.IsTemplateRequest(configJson) {
+		expanded, err := graphgen.Expand(configJson)
+		if err != nil {
+			return "", fmt.Errorf("expanding graph template: %w", err)
+		}
+		configJson = expanded
+	}
+
+	registerVoiceAssistantExtension()
+
+	sessionManager, err := newMeetingSessionManager(configJson)
+	if err != nil {
+		return "", fmt.Errorf("configuring session manager: %w", err)
+	}
+	registerSessionDispatcherExtension(sessionManager)
+
+	authMiddleware, err := NewAuthMiddleware(authOptionsFromConfig(configJson)...)
+	if err != nil {
+		return "", fmt.Errorf("configuring auth middleware: %w", err)
+	}
+	registerAuthExtension(authMiddleware)
+
+	return configJson, nil
+}
+
+// authOptionsFromConfig builds the AppAuthOptions for the "auth" section of
+// configJson. A missing section leaves the middleware with no registered
+// apps, so every message is denied until one is added.
+func authOptionsFromConfig(configJson string) []AppAuthOption {
+	var full struct {
+		Auth authConfig `json:"auth"`
+	}
+	if configJson != "" {
+		if err := json.Unmarshal([]byte(configJson), &full); err != nil {
+			log.Printf("Meeting Assistant: ignoring invalid auth config: %v", err)
+			return nil
+		}
+	}
+
+	var opts []AppAuthOption
+	if full.Auth.AppID != "" && full.Auth.PrivateKeyPEM != "" {
+		opts = append(opts, WithAppAuth(full.Auth.AppID, []byte(full.Auth.PrivateKeyPEM)))
+	}
+	if full.Auth.StaticToken != "" {
+		opts = append(opts, WithTokenAuth(full.Auth.StaticToken))
+	}
+	return opts
+}
@@ -0,0 +1,321 @@
+// Package assistantpb contains the hand-trimmed subset of the
+// google.assistant.embedded.v1alpha2 API that this extension talks to,
+// scoped to the Converse RPC only (see embedded_assistant.proto).
+//
+// There's no protoc/protoc-gen-go step in this tree, so instead of
+// hand-rolling a non-standard wire format, the message descriptors below are
+// built programmatically (protodesc + dynamicpb) from the same field
+// numbers and types as embedded_assistant.proto. The resulting types are
+// real proto.Message values, marshaled with grpc's standard "proto" codec,
+// so they interoperate with the actual EmbeddedAssistant service instead of
+// just with themselves.
+package assistantpb
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/proto" // registers the standard "proto" codec.
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// DialogStateOut_MicrophoneMode mirrors the enum of the same name on the
+// upstream DialogStateOut message.
+type DialogStateOut_MicrophoneMode int32
+
+const (
+	DialogStateOut_MICROPHONE_MODE_UNSPECIFIED DialogStateOut_MicrophoneMode = 0
+	DialogStateOut_CLOSE_MICROPHONE            DialogStateOut_MicrophoneMode = 1
+	DialogStateOut_DIALOG_FOLLOW_ON            DialogStateOut_MicrophoneMode = 2
+)
+
+const audioInConfigEncodingLinear16 = 1
+
+var (
+	fileDescriptor = mustBuildFileDescriptor()
+
+	converseRequestDescriptor  = mustMessage(fileDescriptor, "ConverseRequest")
+	converseConfigDescriptor   = mustMessage(fileDescriptor, "ConverseConfig")
+	audioInConfigDescriptor    = mustMessage(fileDescriptor, "AudioInConfig")
+	converseResponseDescriptor = mustMessage(fileDescriptor, "ConverseResponse")
+	audioOutDescriptor         = mustMessage(fileDescriptor, "AudioOut")
+	dialogStateOutDescriptor   = mustMessage(fileDescriptor, "DialogStateOut")
+
+	fdConverseRequestConfig       = mustField(converseRequestDescriptor, "config")
+	fdConverseRequestAudioIn      = mustField(converseRequestDescriptor, "audio_in")
+	fdConverseConfigAudioInConfig = mustField(converseConfigDescriptor, "audio_in_config")
+	fdAudioInConfigEncoding       = mustField(audioInConfigDescriptor, "encoding")
+	fdAudioInConfigSampleRate     = mustField(audioInConfigDescriptor, "sample_rate_hertz")
+	fdConverseResponseAudioOut    = mustField(converseResponseDescriptor, "audio_out")
+	fdConverseResponseDialogState = mustField(converseResponseDescriptor, "dialog_state_out")
+	fdAudioOutAudioData           = mustField(audioOutDescriptor, "audio_data")
+	fdDialogStateOutText          = mustField(dialogStateOutDescriptor, "supplemental_display_text")
+	fdDialogStateOutMicMode       = mustField(dialogStateOutDescriptor, "microphone_mode")
+)
+
+// mustBuildFileDescriptor assembles the FileDescriptorProto for
+// embedded_assistant.proto by hand and resolves it into a live
+// protoreflect.FileDescriptor. It only ever runs once, at package init, over
+// a fixed, well-formed literal, so a failure here is a programming error in
+// this package rather than bad operator input, hence the panic.
+func mustBuildFileDescriptor() protoreflect.FileDescriptor {
+	const pkg = "google.assistant.embedded.v1alpha2"
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    strPtr("meeting_assistant/embedded_assistant.proto"),
+		Package: strPtr(pkg),
+		Syntax:  strPtr("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: strPtr("ConverseRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("config", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, "."+pkg+".ConverseConfig", int32Ptr(0)),
+					field("audio_in", 2, descriptorpb.FieldDescriptorProto_TYPE_BYTES, "", int32Ptr(0)),
+				},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{
+					{Name: strPtr("converse_request")},
+				},
+			},
+			{
+				Name: strPtr("ConverseConfig"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("audio_in_config", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, "."+pkg+".AudioInConfig", nil),
+				},
+			},
+			{
+				Name: strPtr("AudioInConfig"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("encoding", 1, descriptorpb.FieldDescriptorProto_TYPE_ENUM, "."+pkg+".AudioInConfig.Encoding", nil),
+					field("sample_rate_hertz", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, "", nil),
+				},
+				EnumType: []*descriptorpb.EnumDescriptorProto{
+					{
+						Name: strPtr("Encoding"),
+						Value: []*descriptorpb.EnumValueDescriptorProto{
+							{Name: strPtr("ENCODING_UNSPECIFIED"), Number: int32Ptr(0)},
+							{Name: strPtr("LINEAR16"), Number: int32Ptr(1)},
+							{Name: strPtr("FLAC"), Number: int32Ptr(2)},
+						},
+					},
+				},
+			},
+			{
+				Name: strPtr("ConverseResponse"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("audio_out", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, "."+pkg+".AudioOut", nil),
+					field("dialog_state_out", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, "."+pkg+".DialogStateOut", nil),
+				},
+			},
+			{
+				Name: strPtr("AudioOut"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("audio_data", 1, descriptorpb.FieldDescriptorProto_TYPE_BYTES, "", nil),
+				},
+			},
+			{
+				Name: strPtr("DialogStateOut"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("supplemental_display_text", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", nil),
+					field("microphone_mode", 2, descriptorpb.FieldDescriptorProto_TYPE_ENUM, "."+pkg+".DialogStateOut.MicrophoneMode", nil),
+				},
+				EnumType: []*descriptorpb.EnumDescriptorProto{
+					{
+						Name: strPtr("MicrophoneMode"),
+						Value: []*descriptorpb.EnumValueDescriptorProto{
+							{Name: strPtr("MICROPHONE_MODE_UNSPECIFIED"), Number: int32Ptr(0)},
+							{Name: strPtr("CLOSE_MICROPHONE"), Number: int32Ptr(1)},
+							{Name: strPtr("DIALOG_FOLLOW_ON"), Number: int32Ptr(2)},
+						},
+					},
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(fmt.Sprintf("assistantpb: building file descriptor: %v", err))
+	}
+	return fd
+}
+
+func field(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string, oneofIndex *int32) *descriptorpb.FieldDescriptorProto {
+	fd := &descriptorpb.FieldDescriptorProto{
+		Name:     strPtr(name),
+		Number:   int32Ptr(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+		JsonName: strPtr(name),
+	}
+	if typeName != "" {
+		fd.TypeName = strPtr(typeName)
+	}
+	if oneofIndex != nil {
+		fd.OneofIndex = oneofIndex
+	}
+	return fd
+}
+
+func strPtr(s string) *string { return &s }
+func int32Ptr(i int32) *int32 { return &i }
+
+func mustMessage(fd protoreflect.FileDescriptor, name string) protoreflect.MessageDescriptor {
+	md := fd.Messages().ByName(protoreflect.Name(name))
+	if md == nil {
+		panic("assistantpb: missing message descriptor " + name)
+	}
+	return md
+}
+
+func mustField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fd := md.Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		panic(fmt.Sprintf("assistantpb: missing field %s on %s", name, md.Name()))
+	}
+	return fd
+}
+
+// ConverseRequest is one message on the client->server half of the stream.
+// It embeds *dynamicpb.Message, which promotes Reset/String/ProtoReflect, so
+// a *ConverseRequest is a real proto.Message that marshals through grpc's
+// standard "proto" codec.
+type ConverseRequest struct {
+	*dynamicpb.Message
+}
+
+func newConverseRequest() *ConverseRequest {
+	return &ConverseRequest{Message: dynamicpb.NewMessage(converseRequestDescriptor)}
+}
+
+// NewConverseConfigRequest builds the ConverseRequest that must open every
+// Converse stream, before any audio: it tells the server the audio encoding
+// and sample rate of the AudioIn chunks that will follow.
+func NewConverseConfigRequest(sampleRateHz int32) *ConverseRequest {
+	audioInConfig := dynamicpb.NewMessage(audioInConfigDescriptor)
+	audioInConfig.Set(fdAudioInConfigEncoding, protoreflect.ValueOfEnum(audioInConfigEncodingLinear16))
+	audioInConfig.Set(fdAudioInConfigSampleRate, protoreflect.ValueOfInt32(sampleRateHz))
+
+	converseConfig := dynamicpb.NewMessage(converseConfigDescriptor)
+	converseConfig.Set(fdConverseConfigAudioInConfig, protoreflect.ValueOfMessage(audioInConfig))
+
+	req := newConverseRequest()
+	req.Set(fdConverseRequestConfig, protoreflect.ValueOfMessage(converseConfig))
+	return req
+}
+
+// NewAudioInRequest builds a ConverseRequest carrying one chunk of raw,
+// already-configured PCM audio.
+func NewAudioInRequest(pcm []byte) *ConverseRequest {
+	req := newConverseRequest()
+	req.Set(fdConverseRequestAudioIn, protoreflect.ValueOfBytes(pcm))
+	return req
+}
+
+// ConverseResponse is one message on the server->client half of the stream.
+type ConverseResponse struct {
+	*dynamicpb.Message
+}
+
+func newConverseResponse() *ConverseResponse {
+	return &ConverseResponse{Message: dynamicpb.NewMessage(converseResponseDescriptor)}
+}
+
+func (r *ConverseResponse) GetAudioOut() *AudioOut {
+	if r == nil || !r.Has(fdConverseResponseAudioOut) {
+		return nil
+	}
+	return &AudioOut{msg: r.Get(fdConverseResponseAudioOut).Message()}
+}
+
+func (r *ConverseResponse) GetDialogStateOut() *DialogStateOut {
+	if r == nil || !r.Has(fdConverseResponseDialogState) {
+		return nil
+	}
+	return &DialogStateOut{msg: r.Get(fdConverseResponseDialogState).Message()}
+}
+
+// AudioOut carries synthesized reply audio.
+type AudioOut struct {
+	msg protoreflect.Message
+}
+
+func (a *AudioOut) GetAudioData() []byte {
+	if a == nil {
+		return nil
+	}
+	return a.msg.Get(fdAudioOutAudioData).Bytes()
+}
+
+// DialogStateOut carries the turn's transcript and conversation state.
+type DialogStateOut struct {
+	msg protoreflect.Message
+}
+
+func (d *DialogStateOut) GetSupplementalDisplayText() string {
+	if d == nil {
+		return ""
+	}
+	return d.msg.Get(fdDialogStateOutText).String()
+}
+
+func (d *DialogStateOut) GetMicrophoneMode() DialogStateOut_MicrophoneMode {
+	if d == nil {
+		return DialogStateOut_MICROPHONE_MODE_UNSPECIFIED
+	}
+	return DialogStateOut_MicrophoneMode(d.msg.Get(fdDialogStateOutMicMode).Enum())
+}
+
+// EmbeddedAssistant_ConverseClient is the bidirectional stream handle
+// returned by Converse.
+type EmbeddedAssistant_ConverseClient interface {
+	Send(*ConverseRequest) error
+	Recv() (*ConverseResponse, error)
+	CloseSend() error
+}
+
+// EmbeddedAssistantClient is the subset of the generated client this
+// extension depends on.
+type EmbeddedAssistantClient interface {
+	Converse(ctx context.Context, opts ...grpc.CallOption) (EmbeddedAssistant_ConverseClient, error)
+}
+
+type embeddedAssistantClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewEmbeddedAssistantClient constructs a client bound to conn, mirroring
+// the generated constructor of the same name.
+func NewEmbeddedAssistantClient(conn *grpc.ClientConn) EmbeddedAssistantClient {
+	return &embeddedAssistantClient{cc: conn}
+}
+
+func (c *embeddedAssistantClient) Converse(ctx context.Context, opts ...grpc.CallOption) (EmbeddedAssistant_ConverseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &grpc.StreamDesc{
+		StreamName:    "Converse",
+		ServerStreams: true,
+		ClientStreams: true,
+	}, "/google.assistant.embedded.v1alpha2.EmbeddedAssistant/Converse", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &embeddedAssistantConverseClient{ClientStream: stream}, nil
+}
+
+type embeddedAssistantConverseClient struct {
+	grpc.ClientStream
+}
+
+func (x *embeddedAssistantConverseClient) Send(req *ConverseRequest) error {
+	return x.ClientStream.SendMsg(req)
+}
+
+func (x *embeddedAssistantConverseClient) Recv() (*ConverseResponse, error) {
+	resp := newConverseResponse()
+	if err := x.ClientStream.RecvMsg(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
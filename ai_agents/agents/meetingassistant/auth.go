@@ -0,0 +1,199 @@
+package meetingassistant
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+const (
+	maxAppJWTTTL      = 10 * time.Minute
+	sessionTokenTTL   = time.Hour
+	sessionTokenBytes = 32
+)
+
+// appCredential is one registered "app": an app ID and the RSA private key
+// whose public half verifies JWTs it signs, modeled on the GitHub App
+// installation-token flow.
+type appCredential struct {
+	appID      string
+	privateKey *rsa.PrivateKey
+}
+
+// sessionToken is the opaque credential issued in exchange for a valid app
+// JWT, cached in-memory until it expires.
+type sessionToken struct {
+	appID     string
+	scopes    map[string]struct{}
+	expiresAt time.Time
+}
+
+// AuthMiddleware gates every inbound client connection on a signed
+// credential: clients present a short-lived JWT signed by a registered
+// app's private key, and the middleware exchanges it for an opaque session
+// token that is then required on every subsequent message.
+type AuthMiddleware struct {
+	mu     sync.Mutex
+	apps   map[string]*appCredential
+	tokens map[string]*sessionToken
+
+	staticToken string
+}
+
+// AppAuthOption configures an AuthMiddleware. It mirrors the
+// With*-configurator convention used elsewhere on TenApp, but — like
+// newMeetingSessionManager and graphgen.Expand — reports bad operator input
+// as an error rather than crashing the process.
+type AppAuthOption func(*AuthMiddleware) error
+
+// WithAppAuth registers an app identified by appID whose clients authenticate
+// with JWTs signed by the RSA key in privateKeyPEM (`iss`=appID, `iat`,
+// `exp`<=10m).
+func WithAppAuth(appID string, privateKeyPEM []byte) AppAuthOption {
+	return func(m *AuthMiddleware) error {
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
+		if err != nil {
+			return fmt.Errorf("invalid private key for app %q: %w", appID, err)
+		}
+		m.apps[appID] = &appCredential{appID: appID, privateKey: key}
+		return nil
+	}
+}
+
+// WithTokenAuth accepts a single static bearer token instead of the app JWT
+// flow, for operators who don't need per-app credentials.
+func WithTokenAuth(token string) AppAuthOption {
+	return func(m *AuthMiddleware) error {
+		m.staticToken = token
+		return nil
+	}
+}
+
+// NewAuthMiddleware builds an AuthMiddleware from the given options,
+// returning the first error any option reports.
+func NewAuthMiddleware(opts ...AppAuthOption) (*AuthMiddleware, error) {
+	m := &AuthMiddleware{
+		apps:   make(map[string]*appCredential),
+		tokens: make(map[string]*sessionToken),
+	}
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ExchangeAppJWT validates an app-signed JWT and, if valid, mints an opaque
+// session token scoped to the claims in the JWT's "scope" claim.
+func (m *AuthMiddleware) ExchangeAppJWT(rawJWT string) (string, error) {
+	var claims struct {
+		jwt.RegisteredClaims
+		Scope string `json:"scope"`
+	}
+
+	var matchedApp *appCredential
+	_, err := jwt.ParseWithClaims(rawJWT, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+
+		m.mu.Lock()
+		app, ok := m.apps[claims.Issuer]
+		m.mu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown app %q", claims.Issuer)
+		}
+		matchedApp = app
+		return &app.privateKey.PublicKey, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid app JWT: %w", err)
+	}
+
+	if claims.ExpiresAt == nil || claims.IssuedAt == nil {
+		return "", fmt.Errorf("app JWT must set iat and exp")
+	}
+	if claims.ExpiresAt.Time.Sub(claims.IssuedAt.Time) > maxAppJWTTTL {
+		return "", fmt.Errorf("app JWT exp must be within %s of iat", maxAppJWTTTL)
+	}
+
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("minting session token: %w", err)
+	}
+
+	m.mu.Lock()
+	m.tokens[token] = &sessionToken{
+		appID:     matchedApp.appID,
+		scopes:    parseScopes(claims.Scope),
+		expiresAt: time.Now().Add(sessionTokenTTL),
+	}
+	m.mu.Unlock()
+
+	return token, nil
+}
+
+// Authorize denies msg unless it carries a session token that is present,
+// unexpired, and holds the claim scope requiredScope, which callers set to
+// the name of the extension the message targets. The static token from
+// WithTokenAuth, if configured, always satisfies any scope.
+func (m *AuthMiddleware) Authorize(msg ten_runtime_go.Msg, requiredScope string) error {
+	token, ok := msg.GetPropertyString("session_token")
+	if !ok || token == "" {
+		return fmt.Errorf("missing session_token")
+	}
+
+	if m.staticToken != "" && token == m.staticToken {
+		return nil
+	}
+
+	m.mu.Lock()
+	entry, ok := m.tokens[token]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown session_token")
+	}
+	if time.Now().After(entry.expiresAt) {
+		m.mu.Lock()
+		delete(m.tokens, token)
+		m.mu.Unlock()
+		return fmt.Errorf("session_token expired")
+	}
+	if requiredScope != "" {
+		if _, granted := entry.scopes[requiredScope]; !granted {
+			return fmt.Errorf("session_token lacks required scope %q", requiredScope)
+		}
+	}
+
+	return nil
+}
+
+func parseScopes(scope string) map[string]struct{} {
+	scopes := make(map[string]struct{})
+	start := 0
+	for i := 0; i <= len(scope); i++ {
+		if i == len(scope) || scope[i] == ' ' {
+			if i > start {
+				scopes[scope[start:i]] = struct{}{}
+			}
+			start = i + 1
+		}
+	}
+	return scopes
+}
+
+func newOpaqueToken() (string, error) {
+	buf := make([]byte, sessionTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
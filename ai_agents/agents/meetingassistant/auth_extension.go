@@ -0,0 +1,94 @@
+package meetingassistant
+
+import (
+	"fmt"
+
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+const authExtensionName = "auth"
+
+// authExtension sits in front of the rest of the graph and denies any
+// command or data message that fails AuthMiddleware.Authorize before it
+// reaches its target extension.
+type authExtension struct {
+	ten_runtime_go.DefaultExtension
+
+	middleware *AuthMiddleware
+}
+
+func newAuthExtension(middleware *AuthMiddleware) func(name string) ten_runtime_go.Extension {
+	return func(name string) ten_runtime_go.Extension {
+		return &authExtension{middleware: middleware}
+	}
+}
+
+func (e *authExtension) OnCmd(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd) {
+	name, _ := cmd.GetName()
+
+	if name == "exchange_app_jwt" {
+		e.handleExchange(tenEnv, cmd)
+		return
+	}
+
+	targetScope, _ := cmd.GetPropertyString("target_extension")
+	if err := e.middleware.Authorize(cmd, targetScope); err != nil {
+		tenEnv.LogWarn(fmt.Sprintf("auth: denying cmd %q: %v", name, err))
+		tenEnv.ReturnResult(ten_runtime_go.CmdResultError(cmd, err.Error()), cmd)
+		return
+	}
+
+	// Authorized: forward the command on to its target extension and relay
+	// whatever result comes back, instead of acknowledging it ourselves.
+	if err := tenEnv.SendCmd(cmd, func(tenEnv ten_runtime_go.TenEnv, result ten_runtime_go.CmdResult, err error) {
+		if err != nil {
+			tenEnv.LogError(fmt.Sprintf("auth: forwarding cmd %q failed: %v", name, err))
+			tenEnv.ReturnResult(ten_runtime_go.CmdResultError(cmd, err.Error()), cmd)
+			return
+		}
+		tenEnv.ReturnResult(result, cmd)
+	}); err != nil {
+		tenEnv.LogError(fmt.Sprintf("auth: failed to forward cmd %q: %v", name, err))
+		tenEnv.ReturnResult(ten_runtime_go.CmdResultError(cmd, err.Error()), cmd)
+	}
+}
+
+func (e *authExtension) OnData(tenEnv ten_runtime_go.TenEnv, data ten_runtime_go.Data) {
+	targetScope, _ := data.GetPropertyString("target_extension")
+	if err := e.middleware.Authorize(data, targetScope); err != nil {
+		tenEnv.LogWarn(fmt.Sprintf("auth: dropping data message: %v", err))
+		return
+	}
+	tenEnv.SendData(data)
+}
+
+// handleExchange services the "exchange_app_jwt" command: clients present a
+// signed app JWT and get back an opaque session token to attach to every
+// subsequent message.
+func (e *authExtension) handleExchange(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd) {
+	rawJWT, ok := cmd.GetPropertyString("jwt")
+	if !ok || rawJWT == "" {
+		tenEnv.ReturnResult(ten_runtime_go.CmdResultError(cmd, "missing \"jwt\" property"), cmd)
+		return
+	}
+
+	token, err := e.middleware.ExchangeAppJWT(rawJWT)
+	if err != nil {
+		tenEnv.LogWarn(fmt.Sprintf("auth: jwt exchange failed: %v", err))
+		tenEnv.ReturnResult(ten_runtime_go.CmdResultError(cmd, err.Error()), cmd)
+		return
+	}
+
+	result := ten_runtime_go.CmdResultOK(cmd)
+	result.SetPropertyString("session_token", token)
+	tenEnv.ReturnResult(result, cmd)
+}
+
+// registerAuthExtension wires an auth extension backed by middleware into
+// the addon registry.
+func registerAuthExtension(middleware *AuthMiddleware) {
+	ten_runtime_go.RegisterAddonAsExtension(
+		authExtensionName,
+		ten_runtime_go.NewDefaultExtensionAddon(newAuthExtension(middleware)),
+	)
+}
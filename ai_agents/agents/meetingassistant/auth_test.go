@@ -0,0 +1,162 @@
+package meetingassistant
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// fakeMsg implements the one ten_runtime_go.Msg method Authorize needs, so
+// it can be exercised without a real TEN message.
+type fakeMsg map[string]string
+
+func (m fakeMsg) GetPropertyString(name string) (string, bool) {
+	v, ok := m[name]
+	return v, ok
+}
+
+func generateTestAppKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+	pemBytes := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	return key, pemBytes
+}
+
+func signTestAppJWT(t *testing.T, key *rsa.PrivateKey, appID string, iat, exp time.Time, scope string) string {
+	t.Helper()
+	claims := jwt.MapClaims{
+		"iss":   appID,
+		"iat":   jwt.NewNumericDate(iat),
+		"exp":   jwt.NewNumericDate(exp),
+		"scope": scope,
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("signing app JWT: %v", err)
+	}
+	return signed
+}
+
+func TestExchangeAppJWT(t *testing.T) {
+	key, pemBytes := generateTestAppKey(t)
+	m, err := NewAuthMiddleware(WithAppAuth("acme-app", pemBytes))
+	if err != nil {
+		t.Fatalf("NewAuthMiddleware: %v", err)
+	}
+
+	now := time.Now()
+	rawJWT := signTestAppJWT(t, key, "acme-app", now, now.Add(5*time.Minute), "voice_assistant session_dispatcher")
+
+	token, err := m.ExchangeAppJWT(rawJWT)
+	if err != nil {
+		t.Fatalf("ExchangeAppJWT: %v", err)
+	}
+	if token == "" {
+		t.Fatal("ExchangeAppJWT returned an empty token")
+	}
+}
+
+func TestExchangeAppJWTRejectsLongTTL(t *testing.T) {
+	key, pemBytes := generateTestAppKey(t)
+	m, err := NewAuthMiddleware(WithAppAuth("acme-app", pemBytes))
+	if err != nil {
+		t.Fatalf("NewAuthMiddleware: %v", err)
+	}
+
+	now := time.Now()
+	rawJWT := signTestAppJWT(t, key, "acme-app", now, now.Add(time.Hour), "voice_assistant")
+
+	if _, err := m.ExchangeAppJWT(rawJWT); err == nil {
+		t.Fatal("ExchangeAppJWT: expected error for exp beyond maxAppJWTTTL, got nil")
+	}
+}
+
+func TestExchangeAppJWTUnknownApp(t *testing.T) {
+	key, _ := generateTestAppKey(t)
+	m, err := NewAuthMiddleware()
+	if err != nil {
+		t.Fatalf("NewAuthMiddleware: %v", err)
+	}
+
+	now := time.Now()
+	rawJWT := signTestAppJWT(t, key, "acme-app", now, now.Add(time.Minute), "voice_assistant")
+
+	if _, err := m.ExchangeAppJWT(rawJWT); err == nil {
+		t.Fatal("ExchangeAppJWT: expected error for an unregistered app, got nil")
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	key, pemBytes := generateTestAppKey(t)
+	m, err := NewAuthMiddleware(WithAppAuth("acme-app", pemBytes))
+	if err != nil {
+		t.Fatalf("NewAuthMiddleware: %v", err)
+	}
+
+	now := time.Now()
+	rawJWT := signTestAppJWT(t, key, "acme-app", now, now.Add(time.Minute), "voice_assistant")
+	token, err := m.ExchangeAppJWT(rawJWT)
+	if err != nil {
+		t.Fatalf("ExchangeAppJWT: %v", err)
+	}
+
+	if err := m.Authorize(fakeMsg{"session_token": token}, "voice_assistant"); err != nil {
+		t.Errorf("Authorize: %v", err)
+	}
+	if err := m.Authorize(fakeMsg{"session_token": token}, "session_dispatcher"); err == nil {
+		t.Error("Authorize: expected error for a scope the token wasn't granted, got nil")
+	}
+	if err := m.Authorize(fakeMsg{"session_token": "bogus"}, "voice_assistant"); err == nil {
+		t.Error("Authorize: expected error for an unknown session_token, got nil")
+	}
+	if err := m.Authorize(fakeMsg{}, "voice_assistant"); err == nil {
+		t.Error("Authorize: expected error for a missing session_token, got nil")
+	}
+}
+
+func TestAuthorizeStaticToken(t *testing.T) {
+	m, err := NewAuthMiddleware(WithTokenAuth("static-secret"))
+	if err != nil {
+		t.Fatalf("NewAuthMiddleware: %v", err)
+	}
+	if err := m.Authorize(fakeMsg{"session_token": "static-secret"}, "anything"); err != nil {
+		t.Errorf("Authorize: %v", err)
+	}
+}
+
+func TestParseScopes(t *testing.T) {
+	cases := []struct {
+		scope string
+		want  []string
+	}{
+		{"", nil},
+		{"voice_assistant", []string{"voice_assistant"}},
+		{"voice_assistant session_dispatcher", []string{"voice_assistant", "session_dispatcher"}},
+		{"  voice_assistant  session_dispatcher  ", []string{"voice_assistant", "session_dispatcher"}},
+	}
+
+	for _, c := range cases {
+		got := parseScopes(c.scope)
+		if len(got) != len(c.want) {
+			t.Errorf("parseScopes(%q) = %v, want %v", c.scope, got, c.want)
+			continue
+		}
+		for _, w := range c.want {
+			if _, ok := got[w]; !ok {
+				t.Errorf("parseScopes(%q) = %v, want %v", c.scope, got, c.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Package graphgen renders TEN graph JSON from a compact declarative spec,
+// so meeting room topologies can be described in terms of participants and
+// provider choices instead of hand-written node/connection graphs.
+package graphgen
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+//go:embed templates/*.json.tmpl
+var builtinTemplates embed.FS
+
+// Spec is the compact declarative input used to render a meeting graph.
+type Spec struct {
+	Template     string   `json:"template"`
+	Participants []string `json:"participants"`
+	ASRProvider  string   `json:"asr_provider"`
+	LLMProvider  string   `json:"llm_provider"`
+	Recording    bool     `json:"recording"`
+	Language     string   `json:"language"`
+}
+
+// request is the `{"template": "...", "vars": {...}}` envelope OnConfigure
+// accepts as an alternative to a full graph JSON.
+type request struct {
+	Template string `json:"template"`
+	Vars     Spec   `json:"vars"`
+}
+
+// IsTemplateRequest reports whether configJson is a {"template": ...} form
+// rather than a full graph JSON, so callers can decide whether to expand it
+// before handing it to the runtime.
+func IsTemplateRequest(configJson string) bool {
+	var probe struct {
+		Template string `json:"template"`
+	}
+	if err := json.Unmarshal([]byte(configJson), &probe); err != nil {
+		return false
+	}
+	return probe.Template != ""
+}
+
+// Expand renders configJson's `{"template": "...", "vars": {...}}` form into
+// full TEN graph JSON.
+func Expand(configJson string) (string, error) {
+	var req request
+	if err := json.Unmarshal([]byte(configJson), &req); err != nil {
+		return "", fmt.Errorf("parsing template request: %w", err)
+	}
+	req.Vars.Template = req.Template
+	return Render(req.Vars)
+}
+
+// templateFuncs exposes "json" to the built-in templates so operator-
+// supplied fields (participant names, language codes, provider names) are
+// escaped as JSON string literals instead of interpolated raw. text/template
+// doesn't escape for its output format the way html/template does for HTML,
+// so every field that lands inside a JSON string in a template must be
+// piped through this.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+}
+
+// Render expands the built-in template named by spec.Template with spec's
+// variables into graph JSON.
+func Render(spec Spec) (string, error) {
+	tmplBytes, err := builtinTemplates.ReadFile(fmt.Sprintf("templates/%s.json.tmpl", spec.Template))
+	if err != nil {
+		return "", fmt.Errorf("unknown graph template %q", spec.Template)
+	}
+
+	tmpl, err := template.New(spec.Template).Funcs(templateFuncs).Parse(string(tmplBytes))
+	if err != nil {
+		return "", fmt.Errorf("parsing template %q: %w", spec.Template, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, spec); err != nil {
+		return "", fmt.Errorf("rendering template %q: %w", spec.Template, err)
+	}
+
+	// Round-trip through encoding/json so a template whitespace mistake
+	// surfaces as a render error now, not as a runtime failure inside TEN.
+	var parsed interface{}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		return "", fmt.Errorf("template %q did not render valid JSON: %w", spec.Template, err)
+	}
+	pretty, err := json.MarshalIndent(parsed, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(pretty), nil
+}
+
+// Validate renders spec and runs the result through the TEN schema
+// validator without starting the app, so operators can CI-check meeting
+// configs.
+func Validate(spec Spec) error {
+	graphJson, err := Render(spec)
+	if err != nil {
+		return err
+	}
+	return ten_runtime_go.ValidateGraphJSON(graphJson)
+}
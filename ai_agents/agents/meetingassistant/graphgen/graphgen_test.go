@@ -0,0 +1,148 @@
+package graphgen
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestIsTemplateRequest(t *testing.T) {
+	cases := []struct {
+		configJson string
+		want       bool
+	}{
+		{`{"template": "transcribe-only", "vars": {}}`, true},
+		{`{"nodes": [], "connections": []}`, false},
+		{`not json`, false},
+		{``, false},
+	}
+
+	for _, c := range cases {
+		if got := IsTemplateRequest(c.configJson); got != c.want {
+			t.Errorf("IsTemplateRequest(%q) = %v, want %v", c.configJson, got, c.want)
+		}
+	}
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	if _, err := Render(Spec{Template: "does-not-exist"}); err == nil {
+		t.Fatal("Render: expected error for an unknown template, got nil")
+	}
+}
+
+func TestRenderProducesValidJSON(t *testing.T) {
+	spec := Spec{
+		Template:     "transcribe-only",
+		ASRProvider:  "google",
+		Language:     "en-US",
+		Recording:    true,
+		Participants: []string{"alice", "bob"},
+	}
+
+	out, err := Render(spec)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("Render produced invalid JSON: %v\n%s", err, out)
+	}
+	if !strings.Contains(out, `"meeting_recorder"`) {
+		t.Errorf("Render with Recording=true should include the recorder node, got:\n%s", out)
+	}
+}
+
+// TestRenderEscapesUserInput guards against the JSON-injection class of bug:
+// operator-supplied fields must not be able to break out of their JSON
+// string literal and inject extra nodes/fields into the rendered graph.
+func TestRenderEscapesUserInput(t *testing.T) {
+	spec := Spec{
+		Template:    "transcribe-only",
+		ASRProvider: "google",
+		Language:    `en", "addon": "evil_addon`,
+	}
+
+	out, err := Render(spec)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	var parsed struct {
+		Nodes []struct {
+			Addon    string                 `json:"addon"`
+			Property map[string]interface{} `json:"property"`
+		} `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("Render produced invalid JSON for a language value containing a quote: %v\n%s", err, out)
+	}
+
+	for _, n := range parsed.Nodes {
+		if n.Addon == "evil_addon" {
+			t.Fatalf("Render let Language inject a new node, got:\n%s", out)
+		}
+	}
+	if got := parsed.Nodes[0].Property["language"]; got != spec.Language {
+		t.Errorf("rendered language = %q, want %q", got, spec.Language)
+	}
+}
+
+func TestRenderEscapesParticipantNames(t *testing.T) {
+	spec := Spec{
+		Template:     "live-translate",
+		ASRProvider:  "google",
+		LLMProvider:  "openai",
+		Language:     "en-US",
+		Participants: []string{`alice", "addon": "evil_addon`},
+	}
+
+	out, err := Render(spec)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	var parsed struct {
+		Nodes []struct {
+			Name     string                 `json:"name"`
+			Addon    string                 `json:"addon"`
+			Property map[string]interface{} `json:"property"`
+		} `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("Render produced invalid JSON for a participant name containing a quote: %v\n%s", err, out)
+	}
+	for _, n := range parsed.Nodes {
+		if n.Addon == "evil_addon" {
+			t.Fatalf("Render let a participant name inject a new node, got:\n%s", out)
+		}
+	}
+	// An unescaped name would end at its first quote and its tail would be
+	// parsed as extra fields, so the node must carry the whole name verbatim.
+	if len(parsed.Nodes) != 2 {
+		t.Fatalf("Render produced %d nodes, want 2 (asr + one translator):\n%s", len(parsed.Nodes), out)
+	}
+	participant := spec.Participants[0]
+	if got := parsed.Nodes[1].Name; got != "translate_"+participant {
+		t.Errorf("rendered translator name = %q, want %q", got, "translate_"+participant)
+	}
+	if got := parsed.Nodes[1].Property["target_participant"]; got != participant {
+		t.Errorf("rendered target_participant = %q, want %q", got, participant)
+	}
+}
+
+func TestExpand(t *testing.T) {
+	configJson := `{"template": "transcribe-only", "vars": {"asr_provider": "google", "language": "en-US"}}`
+	out, err := Expand(configJson)
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if _, err := parseJSON(out); err != nil {
+		t.Fatalf("Expand produced invalid JSON: %v\n%s", err, out)
+	}
+}
+
+func parseJSON(s string) (interface{}, error) {
+	var v interface{}
+	err := json.Unmarshal([]byte(s), &v)
+	return v, err
+}
@@ -0,0 +1,66 @@
+package mobile
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+const mobileAudioSourceExtensionName = "mobile_audio_source"
+
+// audioSourceExtension is the graph-side endpoint for handle.SendAudioFrame:
+// it holds the TenEnv captured at OnStart so mobile-originated audio can be
+// turned into a real AudioFrame message and pushed into the graph through
+// TenEnv, the same way every other data path in this package works, instead
+// of a bare TenApp method.
+type audioSourceExtension struct {
+	ten_runtime_go.DefaultExtension
+
+	mu     sync.Mutex
+	tenEnv ten_runtime_go.TenEnv
+}
+
+func newAudioSourceExtension(source *audioSourceExtension) func(name string) ten_runtime_go.Extension {
+	return func(name string) ten_runtime_go.Extension {
+		return source
+	}
+}
+
+func (e *audioSourceExtension) OnStart(tenEnv ten_runtime_go.TenEnv) {
+	e.mu.Lock()
+	e.tenEnv = tenEnv
+	e.mu.Unlock()
+	tenEnv.OnStartDone()
+}
+
+// send builds an AudioFrame carrying pcm, with its session_id property set
+// the way voice_assistant_extension.go's OnAudioFrame reads it, and pushes
+// it into the graph.
+func (e *audioSourceExtension) send(sessionID string, pcm []byte) error {
+	e.mu.Lock()
+	tenEnv := e.tenEnv
+	e.mu.Unlock()
+	if tenEnv == nil {
+		return fmt.Errorf("mobile_audio_source: not started yet")
+	}
+
+	frame, err := ten_runtime_go.NewAudioFrame("pcm_frame")
+	if err != nil {
+		return fmt.Errorf("mobile_audio_source: creating audio frame: %w", err)
+	}
+	frame.SetPropertyString("session_id", sessionID)
+	frame.SetBuf(pcm)
+
+	tenEnv.SendAudioFrame(frame)
+	return nil
+}
+
+// registerMobileAudioSource wires source into the addon registry so the
+// graph can host it as the mobile audio ingress point.
+func registerMobileAudioSource(source *audioSourceExtension) {
+	ten_runtime_go.RegisterAddonAsExtension(
+		mobileAudioSourceExtensionName,
+		ten_runtime_go.NewDefaultExtensionAddon(newAudioSourceExtension(source)),
+	)
+}
@@ -0,0 +1,45 @@
+package mobile
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// rfc1034Label matches a single RFC 1034 label: a letter, followed by
+// letters, digits, or hyphens, not ending in a hyphen.
+var rfc1034Label = regexp.MustCompile(`^[A-Za-z](?:[A-Za-z0-9-]*[A-Za-z0-9])?$`)
+
+// javaIdentifier matches a single segment of a Java package name.
+var javaIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateBundleID checks that bundleID is a legal iOS bundle identifier:
+// dot-separated RFC 1034 labels. This mirrors the check `gomobile bind`
+// performs on -prefix before generating the .framework's Info.plist.
+func ValidateBundleID(bundleID string) error {
+	if bundleID == "" {
+		return fmt.Errorf("bundle identifier must not be empty")
+	}
+	for _, label := range strings.Split(bundleID, ".") {
+		if !rfc1034Label.MatchString(label) {
+			return fmt.Errorf("bundle identifier %q: %q is not a valid RFC 1034 label", bundleID, label)
+		}
+	}
+	return nil
+}
+
+// ValidateAndroidPackageName checks that pkg is a legal Android/Java package
+// name. This mirrors the check `gomobile bind` performs on -javapkg before
+// generating the .aar's AndroidManifest.xml.
+func ValidateAndroidPackageName(pkg string) error {
+	segments := strings.Split(pkg, ".")
+	if len(segments) < 2 {
+		return fmt.Errorf("android package name %q must have at least two segments", pkg)
+	}
+	for _, segment := range segments {
+		if !javaIdentifier.MatchString(segment) {
+			return fmt.Errorf("android package name %q: %q is not a valid Java identifier", pkg, segment)
+		}
+	}
+	return nil
+}
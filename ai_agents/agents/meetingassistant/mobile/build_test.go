@@ -0,0 +1,45 @@
+package mobile
+
+import "testing"
+
+func TestValidateBundleID(t *testing.T) {
+	cases := []struct {
+		bundleID string
+		wantErr  bool
+	}{
+		{"com.example.meetingassistant", false},
+		{"com.example.Meeting-Assistant", false},
+		{"", true},
+		{"com..meetingassistant", true},
+		{"com.example.-meetingassistant", true},
+		{"com.example.meetingassistant-", true},
+		{"com.example.1meetingassistant", true},
+	}
+
+	for _, c := range cases {
+		err := ValidateBundleID(c.bundleID)
+		if (err != nil) != c.wantErr {
+			t.Errorf("ValidateBundleID(%q) error = %v, wantErr %v", c.bundleID, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateAndroidPackageName(t *testing.T) {
+	cases := []struct {
+		pkg     string
+		wantErr bool
+	}{
+		{"com.example.meetingassistant", false},
+		{"com.example.meeting_assistant", false},
+		{"meetingassistant", true},
+		{"com.example.123meetingassistant", true},
+		{"com.example.meeting-assistant", true},
+	}
+
+	for _, c := range cases {
+		err := ValidateAndroidPackageName(c.pkg)
+		if (err != nil) != c.wantErr {
+			t.Errorf("ValidateAndroidPackageName(%q) error = %v, wantErr %v", c.pkg, err, c.wantErr)
+		}
+	}
+}
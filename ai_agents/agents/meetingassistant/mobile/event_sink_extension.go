@@ -0,0 +1,70 @@
+package mobile
+
+import (
+	"encoding/json"
+
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+const mobileEventSinkExtensionName = "mobile_event_sink"
+
+// event is the JSON shape PollEvent hands back to mobile callers.
+type event struct {
+	Kind      string `json:"kind"` // "transcript" or "reply_audio"
+	SessionID string `json:"session_id"`
+	Text      string `json:"text,omitempty"`
+	IsFinal   bool   `json:"is_final,omitempty"`
+	Audio     []byte `json:"audio,omitempty"`
+}
+
+// eventSinkExtension receives the transcript/reply_audio data messages the
+// voice assistant extension emits and forwards them, JSON-encoded, to the
+// channel a Handle drains via PollEvent.
+type eventSinkExtension struct {
+	ten_runtime_go.DefaultExtension
+
+	events chan<- []byte
+}
+
+func newEventSinkExtension(events chan<- []byte) func(name string) ten_runtime_go.Extension {
+	return func(name string) ten_runtime_go.Extension {
+		return &eventSinkExtension{events: events}
+	}
+}
+
+func (e *eventSinkExtension) OnData(tenEnv ten_runtime_go.TenEnv, data ten_runtime_go.Data) {
+	name, _ := data.GetName()
+	sessionID, _ := data.GetPropertyString("session_id")
+
+	evt := event{SessionID: sessionID}
+	switch name {
+	case "transcript":
+		evt.Kind = "transcript"
+		evt.Text, _ = data.GetPropertyString("text")
+		evt.IsFinal, _ = data.GetPropertyBool("is_final")
+	case "reply_audio":
+		evt.Kind = "reply_audio"
+		evt.Audio = data.GetBuf()
+	default:
+		return
+	}
+
+	encoded, err := json.Marshal(evt)
+	if err != nil {
+		tenEnv.LogError("mobile_event_sink: failed to marshal event: " + err.Error())
+		return
+	}
+
+	select {
+	case e.events <- encoded:
+	default:
+		tenEnv.LogWarn("mobile_event_sink: event channel full, dropping event")
+	}
+}
+
+func registerMobileEventSink(events chan<- []byte) {
+	ten_runtime_go.RegisterAddonAsExtension(
+		mobileEventSinkExtensionName,
+		ten_runtime_go.NewDefaultExtensionAddon(newEventSinkExtension(events)),
+	)
+}
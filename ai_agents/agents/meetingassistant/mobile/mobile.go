@@ -0,0 +1,111 @@
+// Package mobile provides gomobile-compatible bindings for embedding the
+// meeting assistant directly in an iOS (.framework) or Android (.aar) app.
+// Every exported API takes or returns only string, []byte, int64, or an
+// interface with a fixed method set, per gomobile's binding rules.
+package mobile
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/TEN-framework/ten_framework/ai_agents/agents/meetingassistant"
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+// Handle controls one embedded meeting-assistant instance.
+type Handle interface {
+	// SendAudioFrame pushes one raw PCM frame (16kHz mono LINEAR16) into the
+	// embedded graph.
+	SendAudioFrame(frame []byte)
+
+	// PollEvent returns the next pending transcript or reply-audio event,
+	// JSON-encoded, or nil if none is pending. Mobile callers poll this from
+	// a UI timer since gomobile bindings can't export channels.
+	PollEvent() []byte
+
+	// Stop tears down the embedded TEN app.
+	Stop()
+}
+
+// StartAssistant boots an embedded meeting assistant from configJSON (either
+// a full graph JSON or a {"template": ..., "vars": {...}} form) and returns a
+// Handle for feeding it audio and draining its events. A device only ever
+// embeds one meeting at a time, so StartAssistant mints a single session ID
+// for the Handle's lifetime and SendAudioFrame attaches it to every frame —
+// the same session_id the voice assistant extension keys its Converse
+// streams on, but chosen here instead of by the caller since there's only
+// ever one.
+func StartAssistant(configJSON string) (Handle, error) {
+	sessionID, err := newSessionID()
+	if err != nil {
+		return nil, fmt.Errorf("mobile: generating session id: %w", err)
+	}
+
+	tenApp := ten_runtime_go.NewTenApp()
+	if err := tenApp.SetPropertyFromJSON(configJSON); err != nil {
+		return nil, fmt.Errorf("mobile: setting initial config: %w", err)
+	}
+
+	h := &handle{
+		tenApp:      tenApp,
+		sessionID:   sessionID,
+		events:      make(chan []byte, 64),
+		audioSource: &audioSourceExtension{},
+	}
+
+	configured := make(chan error, 1)
+	tenApp.OnConfigure(func(tenApp ten_runtime_go.TenApp, configJson string) {
+		_, err := meetingassistant.Configure(tenApp, configJson)
+		if err == nil {
+			registerMobileEventSink(h.events)
+			registerMobileAudioSource(h.audioSource)
+		}
+		configured <- err
+	})
+
+	go tenApp.Run(false)
+
+	if err := <-configured; err != nil {
+		h.tenApp.Stop()
+		return nil, fmt.Errorf("mobile: configuring meeting assistant: %w", err)
+	}
+
+	return h, nil
+}
+
+type handle struct {
+	tenApp      ten_runtime_go.TenApp
+	sessionID   string
+	events      chan []byte
+	audioSource *audioSourceExtension
+}
+
+func (h *handle) SendAudioFrame(frame []byte) {
+	// Best-effort: there's no error return across the gomobile boundary, so
+	// a frame sent before the app finishes starting is silently dropped,
+	// the same way the event sink silently drops events when the channel
+	// PollEvent drains is full.
+	_ = h.audioSource.send(h.sessionID, frame)
+}
+
+func (h *handle) PollEvent() []byte {
+	select {
+	case event := <-h.events:
+		return event
+	default:
+		return nil
+	}
+}
+
+func (h *handle) Stop() {
+	h.tenApp.Stop()
+}
+
+func newSessionID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,129 @@
+package meetingassistant
+
+import (
+	"fmt"
+
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+const sessionDispatcherExtensionName = "session_dispatcher"
+
+// sessionDispatcherExtension handles the CreateSession/JoinSession/
+// LeaveSession/TerminateSession commands against a MeetingSessionManager.
+// Every inbound command must carry a "namespace" property; commands without
+// one are rejected before they ever reach the manager.
+type sessionDispatcherExtension struct {
+	ten_runtime_go.DefaultExtension
+
+	manager *MeetingSessionManager
+}
+
+func newSessionDispatcherExtension(manager *MeetingSessionManager) func(name string) ten_runtime_go.Extension {
+	return func(name string) ten_runtime_go.Extension {
+		return &sessionDispatcherExtension{manager: manager}
+	}
+}
+
+func (e *sessionDispatcherExtension) OnCmd(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd) {
+	namespace, ok := cmd.GetPropertyString("namespace")
+	if !ok || namespace == "" {
+		e.fail(tenEnv, cmd, fmt.Errorf("command is missing required \"namespace\" property"))
+		return
+	}
+
+	name, _ := cmd.GetName()
+	switch name {
+	case "create_session":
+		e.handleCreateSession(tenEnv, cmd, namespace)
+	case "join_session":
+		e.handleJoinSession(tenEnv, cmd, namespace)
+	case "leave_session":
+		e.handleLeaveSession(tenEnv, cmd, namespace)
+	case "terminate_session":
+		e.handleTerminateSession(tenEnv, cmd, namespace)
+	default:
+		tenEnv.ReturnResult(ten_runtime_go.CmdResultUnsupported(cmd), cmd)
+	}
+}
+
+func (e *sessionDispatcherExtension) handleCreateSession(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd, namespace string) {
+	meetingID, _ := cmd.GetPropertyString("meeting_id")
+	if meetingID == "" {
+		e.fail(tenEnv, cmd, fmt.Errorf("create_session is missing \"meeting_id\""))
+		return
+	}
+
+	graph, err := tenEnv.GetApp().NewGraph()
+	if err != nil {
+		e.fail(tenEnv, cmd, fmt.Errorf("allocating graph for %s/%s: %w", namespace, meetingID, err))
+		return
+	}
+
+	if _, err := e.manager.CreateSession(namespace, meetingID, graph); err != nil {
+		e.fail(tenEnv, cmd, err)
+		return
+	}
+
+	tenEnv.ReturnResult(ten_runtime_go.CmdResultOK(cmd), cmd)
+}
+
+func (e *sessionDispatcherExtension) handleJoinSession(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd, namespace string) {
+	meetingID, _ := cmd.GetPropertyString("meeting_id")
+	participantID, _ := cmd.GetPropertyString("participant_id")
+	if meetingID == "" || participantID == "" {
+		e.fail(tenEnv, cmd, fmt.Errorf("join_session requires \"meeting_id\" and \"participant_id\""))
+		return
+	}
+
+	if _, err := e.manager.JoinSession(namespace, meetingID, participantID); err != nil {
+		e.fail(tenEnv, cmd, err)
+		return
+	}
+
+	tenEnv.ReturnResult(ten_runtime_go.CmdResultOK(cmd), cmd)
+}
+
+func (e *sessionDispatcherExtension) handleLeaveSession(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd, namespace string) {
+	meetingID, _ := cmd.GetPropertyString("meeting_id")
+	participantID, _ := cmd.GetPropertyString("participant_id")
+	if meetingID == "" || participantID == "" {
+		e.fail(tenEnv, cmd, fmt.Errorf("leave_session requires \"meeting_id\" and \"participant_id\""))
+		return
+	}
+
+	if err := e.manager.LeaveSession(namespace, meetingID, participantID); err != nil {
+		e.fail(tenEnv, cmd, err)
+		return
+	}
+
+	tenEnv.ReturnResult(ten_runtime_go.CmdResultOK(cmd), cmd)
+}
+
+func (e *sessionDispatcherExtension) handleTerminateSession(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd, namespace string) {
+	meetingID, _ := cmd.GetPropertyString("meeting_id")
+	if meetingID == "" {
+		e.fail(tenEnv, cmd, fmt.Errorf("terminate_session is missing \"meeting_id\""))
+		return
+	}
+
+	if err := e.manager.TerminateSession(namespace, meetingID); err != nil {
+		e.fail(tenEnv, cmd, err)
+		return
+	}
+
+	tenEnv.ReturnResult(ten_runtime_go.CmdResultOK(cmd), cmd)
+}
+
+func (e *sessionDispatcherExtension) fail(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd, err error) {
+	tenEnv.LogError(fmt.Sprintf("session_dispatcher: %v", err))
+	tenEnv.ReturnResult(ten_runtime_go.CmdResultError(cmd, err.Error()), cmd)
+}
+
+// registerSessionDispatcherExtension wires a dispatcher extension backed by
+// manager into the addon registry.
+func registerSessionDispatcherExtension(manager *MeetingSessionManager) {
+	ten_runtime_go.RegisterAddonAsExtension(
+		sessionDispatcherExtensionName,
+		ten_runtime_go.NewDefaultExtensionAddon(newSessionDispatcherExtension(manager)),
+	)
+}
@@ -0,0 +1,188 @@
+package meetingassistant
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+// sessionKey identifies a meeting within a namespace. A single process can
+// safely serve independent tenants because every session is addressed by
+// the pair, never by meetingID alone.
+type sessionKey struct {
+	namespace string
+	meetingID string
+}
+
+// namespaceQuota bounds how much of the process one tenant may consume.
+type namespaceQuota struct {
+	MaxConcurrentSessions int `json:"max_concurrent_sessions"`
+	MaxParticipants       int `json:"max_participants"`
+}
+
+// sessionManagerConfig is the shape of the "session_manager" section of the
+// configJson passed to OnConfigure.
+type sessionManagerConfig struct {
+	Quotas map[string]namespaceQuota `json:"namespace_quotas"`
+}
+
+// Session owns one meeting's graph instance and participant roster. It holds
+// no per-session extension configuration.
+type Session struct {
+	Namespace string
+	MeetingID string
+	Graph     ten_runtime_go.Graph
+
+	mu           sync.Mutex
+	participants map[string]struct{}
+}
+
+// addParticipantIfUnderQuota adds participantID and reports true if doing so
+// kept the session at or under maxParticipants, checking and adding under
+// the same lock acquisition so concurrent joins can't both pass the check
+// and push the count over quota. maxParticipants <= 0 means unlimited.
+func (s *Session) addParticipantIfUnderQuota(participantID string, maxParticipants int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, present := s.participants[participantID]; present {
+		return true
+	}
+	if maxParticipants > 0 && len(s.participants) >= maxParticipants {
+		return false
+	}
+	s.participants[participantID] = struct{}{}
+	return true
+}
+
+func (s *Session) removeParticipant(participantID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.participants, participantID)
+}
+
+func (s *Session) participantCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.participants)
+}
+
+// MeetingSessionManager owns every live Session in the process, keyed by
+// namespace and meeting ID, and enforces per-namespace quotas.
+type MeetingSessionManager struct {
+	quotas map[string]namespaceQuota
+
+	mu       sync.Mutex
+	sessions map[sessionKey]*Session
+}
+
+func newMeetingSessionManager(configJson string) (*MeetingSessionManager, error) {
+	var full struct {
+		SessionManager sessionManagerConfig `json:"session_manager"`
+	}
+	if configJson != "" {
+		if err := json.Unmarshal([]byte(configJson), &full); err != nil {
+			return nil, fmt.Errorf("parsing session_manager config: %w", err)
+		}
+	}
+
+	return &MeetingSessionManager{
+		quotas:   full.SessionManager.Quotas,
+		sessions: make(map[sessionKey]*Session),
+	}, nil
+}
+
+func (m *MeetingSessionManager) quotaFor(namespace string) namespaceQuota {
+	if q, ok := m.quotas[namespace]; ok {
+		return q
+	}
+	return namespaceQuota{}
+}
+
+func (m *MeetingSessionManager) countForNamespace(namespace string) int {
+	count := 0
+	for key := range m.sessions {
+		if key.namespace == namespace {
+			count++
+		}
+	}
+	return count
+}
+
+// CreateSession creates a new Session for namespace/meetingID, rejecting the
+// request if the namespace has reached its max_concurrent_sessions quota.
+func (m *MeetingSessionManager) CreateSession(namespace, meetingID string, graph ten_runtime_go.Graph) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := sessionKey{namespace: namespace, meetingID: meetingID}
+	if _, exists := m.sessions[key]; exists {
+		return nil, fmt.Errorf("session %s/%s already exists", namespace, meetingID)
+	}
+
+	quota := m.quotaFor(namespace)
+	if quota.MaxConcurrentSessions > 0 && m.countForNamespace(namespace) >= quota.MaxConcurrentSessions {
+		return nil, fmt.Errorf("namespace %q is at its max_concurrent_sessions quota (%d)", namespace, quota.MaxConcurrentSessions)
+	}
+
+	session := &Session{
+		Namespace:    namespace,
+		MeetingID:    meetingID,
+		Graph:        graph,
+		participants: make(map[string]struct{}),
+	}
+	m.sessions[key] = session
+	return session, nil
+}
+
+// JoinSession adds participantID to an existing session, rejecting the
+// request if that session already holds its namespace's max_participants
+// quota of participants.
+func (m *MeetingSessionManager) JoinSession(namespace, meetingID, participantID string) (*Session, error) {
+	session, err := m.lookup(namespace, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := m.quotaFor(namespace)
+	if !session.addParticipantIfUnderQuota(participantID, quota.MaxParticipants) {
+		return nil, fmt.Errorf("namespace %q is at its max_participants quota (%d)", namespace, quota.MaxParticipants)
+	}
+
+	return session, nil
+}
+
+// LeaveSession removes participantID from an existing session.
+func (m *MeetingSessionManager) LeaveSession(namespace, meetingID, participantID string) error {
+	session, err := m.lookup(namespace, meetingID)
+	if err != nil {
+		return err
+	}
+	session.removeParticipant(participantID)
+	return nil
+}
+
+// TerminateSession tears down and forgets the session for namespace/meetingID.
+func (m *MeetingSessionManager) TerminateSession(namespace, meetingID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := sessionKey{namespace: namespace, meetingID: meetingID}
+	if _, exists := m.sessions[key]; !exists {
+		return fmt.Errorf("session %s/%s does not exist", namespace, meetingID)
+	}
+	delete(m.sessions, key)
+	return nil
+}
+
+func (m *MeetingSessionManager) lookup(namespace, meetingID string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	session, ok := m.sessions[sessionKey{namespace: namespace, meetingID: meetingID}]
+	if !ok {
+		return nil, fmt.Errorf("session %s/%s does not exist", namespace, meetingID)
+	}
+	return session, nil
+}
@@ -0,0 +1,79 @@
+package meetingassistant
+
+import "testing"
+
+func TestCreateSessionRespectsMaxConcurrentSessions(t *testing.T) {
+	m, err := newMeetingSessionManager(`{"session_manager":{"namespace_quotas":{"acme":{"max_concurrent_sessions":1}}}}`)
+	if err != nil {
+		t.Fatalf("newMeetingSessionManager: %v", err)
+	}
+
+	if _, err := m.CreateSession("acme", "standup", nil); err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	if _, err := m.CreateSession("acme", "retro", nil); err == nil {
+		t.Fatal("CreateSession: expected max_concurrent_sessions quota error, got nil")
+	}
+	if _, err := m.CreateSession("other-namespace", "standup", nil); err != nil {
+		t.Fatalf("CreateSession in an unquota'd namespace should not be limited: %v", err)
+	}
+	if _, err := m.CreateSession("acme", "standup", nil); err == nil {
+		t.Fatal("CreateSession: expected duplicate session error, got nil")
+	}
+}
+
+func TestJoinSessionRespectsMaxParticipants(t *testing.T) {
+	m, err := newMeetingSessionManager(`{"session_manager":{"namespace_quotas":{"acme":{"max_participants":2}}}}`)
+	if err != nil {
+		t.Fatalf("newMeetingSessionManager: %v", err)
+	}
+	if _, err := m.CreateSession("acme", "standup", nil); err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+
+	if _, err := m.JoinSession("acme", "standup", "alice"); err != nil {
+		t.Fatalf("JoinSession: %v", err)
+	}
+	if _, err := m.JoinSession("acme", "standup", "bob"); err != nil {
+		t.Fatalf("JoinSession: %v", err)
+	}
+	if _, err := m.JoinSession("acme", "standup", "carol"); err == nil {
+		t.Fatal("JoinSession: expected max_participants quota error, got nil")
+	}
+
+	if err := m.LeaveSession("acme", "standup", "alice"); err != nil {
+		t.Fatalf("LeaveSession: %v", err)
+	}
+	if _, err := m.JoinSession("acme", "standup", "carol"); err != nil {
+		t.Fatalf("JoinSession after a slot freed up: %v", err)
+	}
+}
+
+func TestJoinSessionUnknownSession(t *testing.T) {
+	m, err := newMeetingSessionManager("")
+	if err != nil {
+		t.Fatalf("newMeetingSessionManager: %v", err)
+	}
+	if _, err := m.JoinSession("acme", "standup", "alice"); err == nil {
+		t.Fatal("JoinSession: expected error for an unknown session, got nil")
+	}
+}
+
+func TestTerminateSessionForgetsSession(t *testing.T) {
+	m, err := newMeetingSessionManager("")
+	if err != nil {
+		t.Fatalf("newMeetingSessionManager: %v", err)
+	}
+	if _, err := m.CreateSession("acme", "standup", nil); err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	if err := m.TerminateSession("acme", "standup"); err != nil {
+		t.Fatalf("TerminateSession: %v", err)
+	}
+	if err := m.TerminateSession("acme", "standup"); err == nil {
+		t.Fatal("TerminateSession: expected error terminating an already-terminated session, got nil")
+	}
+	if _, err := m.CreateSession("acme", "standup", nil); err != nil {
+		t.Fatalf("CreateSession after termination should succeed: %v", err)
+	}
+}
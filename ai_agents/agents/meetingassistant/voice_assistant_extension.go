@@ -0,0 +1,254 @@
+package meetingassistant
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/oauth"
+
+	"github.com/TEN-framework/ten_framework/ai_agents/agents/meetingassistant/assistantpb"
+	"github.com/TEN-framework/ten_framework/core/go/binding/ten_runtime_go"
+)
+
+const (
+	voiceAssistantExtensionName = "voice_assistant"
+	assistantEndpoint           = "embeddedassistant.googleapis.com:443"
+	assistantScope              = "https://www.googleapis.com/auth/assistant-sdk-prototype"
+	audioSampleRateHz           = 16000
+	audioChunkBytes             = 1600 // 50ms of 16kHz/16-bit mono PCM.
+)
+
+// voiceAssistantExtension bridges a meeting's audio graph to a Google
+// Assistant-style EmbeddedAssistant.Converse endpoint. Each meeting gets its
+// own bidirectional stream, keyed by session ID, so audio from concurrent
+// meetings is never interleaved on the wire.
+type voiceAssistantExtension struct {
+	ten_runtime_go.DefaultExtension
+
+	conn *grpc.ClientConn
+
+	mu       sync.Mutex
+	sessions map[string]*converseSession
+}
+
+// converseSession wraps one live Converse stream for one meeting.
+type converseSession struct {
+	id     string
+	stream assistantpb.EmbeddedAssistant_ConverseClient
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newVoiceAssistantExtension(name string) ten_runtime_go.Extension {
+	return &voiceAssistantExtension{
+		sessions: make(map[string]*converseSession),
+	}
+}
+
+func (e *voiceAssistantExtension) OnStart(tenEnv ten_runtime_go.TenEnv) {
+	tokenSource, err := google.DefaultTokenSource(context.Background(), assistantScope)
+	if err != nil {
+		tenEnv.LogError(fmt.Sprintf("voice_assistant: failed to load oauth2 token source: %v", err))
+		return
+	}
+
+	conn, err := grpc.NewClient(
+		assistantEndpoint,
+		grpc.WithPerRPCCredentials(oauth.TokenSource{TokenSource: tokenSource}),
+		grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")),
+	)
+	if err != nil {
+		tenEnv.LogError(fmt.Sprintf("voice_assistant: failed to dial %s: %v", assistantEndpoint, err))
+		return
+	}
+	e.conn = conn
+
+	tenEnv.OnStartDone()
+}
+
+func (e *voiceAssistantExtension) OnStop(tenEnv ten_runtime_go.TenEnv) {
+	e.mu.Lock()
+	for _, s := range e.sessions {
+		s.cancel()
+	}
+	e.mu.Unlock()
+
+	if e.conn != nil {
+		e.conn.Close()
+	}
+
+	tenEnv.OnStopDone()
+}
+
+// OnAudioFrame receives raw PCM from the meeting's audio graph and forwards
+// it to the session's Converse stream, starting the stream on first frame.
+func (e *voiceAssistantExtension) OnAudioFrame(tenEnv ten_runtime_go.TenEnv, frame ten_runtime_go.AudioFrame) {
+	sessionID, ok := frame.GetPropertyString("session_id")
+	if !ok || sessionID == "" {
+		tenEnv.LogWarn("voice_assistant: dropping audio frame with no session_id")
+		return
+	}
+
+	session, err := e.sessionFor(tenEnv, sessionID)
+	if err != nil {
+		tenEnv.LogError(fmt.Sprintf("voice_assistant: %v", err))
+		return
+	}
+
+	pcm := frame.GetBuf()
+	for offset := 0; offset < len(pcm); offset += audioChunkBytes {
+		end := offset + audioChunkBytes
+		if end > len(pcm) {
+			end = len(pcm)
+		}
+		req := assistantpb.NewAudioInRequest(pcm[offset:end])
+		if err := session.stream.Send(req); err != nil {
+			tenEnv.LogError(fmt.Sprintf("voice_assistant: session %s send failed: %v", sessionID, err))
+			e.closeSession(sessionID)
+			return
+		}
+	}
+}
+
+// OnCmd handles the "stop_talking" command, which half-closes the stream so
+// the server can flush its final DialogStateOut before io.EOF.
+func (e *voiceAssistantExtension) OnCmd(tenEnv ten_runtime_go.TenEnv, cmd ten_runtime_go.Cmd) {
+	name, _ := cmd.GetName()
+	if name != "stop_talking" {
+		tenEnv.ReturnResult(ten_runtime_go.CmdResultUnsupported(cmd), cmd)
+		return
+	}
+
+	sessionID, _ := cmd.GetPropertyString("session_id")
+	e.mu.Lock()
+	session := e.sessions[sessionID]
+	e.mu.Unlock()
+	if session != nil {
+		session.stream.CloseSend()
+	}
+
+	tenEnv.ReturnResult(ten_runtime_go.CmdResultOK(cmd), cmd)
+}
+
+// sessionFor returns the session for sessionID, opening a new Converse
+// stream if none exists yet. The lock is held across the whole check and
+// create so two concurrent calls for the same sessionID can't both miss the
+// map and each open their own stream.
+func (e *voiceAssistantExtension) sessionFor(tenEnv ten_runtime_go.TenEnv, sessionID string) (*converseSession, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if session, ok := e.sessions[sessionID]; ok {
+		return session, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := assistantpb.NewEmbeddedAssistantClient(e.conn).Converse(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("opening Converse stream for session %s: %w", sessionID, err)
+	}
+
+	// The Converse RPC requires a ConverseConfig as the first client message,
+	// before any AudioIn chunks, so the server knows how to decode them.
+	if err := stream.Send(assistantpb.NewConverseConfigRequest(audioSampleRateHz)); err != nil {
+		cancel()
+		return nil, fmt.Errorf("sending converse config for session %s: %w", sessionID, err)
+	}
+
+	session := &converseSession{
+		id:     sessionID,
+		stream: stream,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	e.sessions[sessionID] = session
+
+	go e.readLoop(tenEnv, session)
+
+	return session, nil
+}
+
+// readLoop drains AudioOut/DialogStateOut responses and emits transcript and
+// reply-audio data messages back into the graph. On a stream error it
+// reconnects the session rather than tearing down the whole extension.
+func (e *voiceAssistantExtension) readLoop(tenEnv ten_runtime_go.TenEnv, session *converseSession) {
+	defer close(session.done)
+
+	for {
+		resp, err := session.stream.Recv()
+		if err == io.EOF {
+			e.closeSession(session.id)
+			return
+		}
+		if err != nil {
+			tenEnv.LogWarn(fmt.Sprintf("voice_assistant: session %s stream error, reconnecting: %v", session.id, err))
+			e.reconnect(tenEnv, session.id)
+			return
+		}
+
+		if audioOut := resp.GetAudioOut(); audioOut != nil {
+			e.emitReplyAudio(tenEnv, session.id, audioOut.GetAudioData())
+		}
+		if stateOut := resp.GetDialogStateOut(); stateOut != nil {
+			e.emitTranscript(tenEnv, session.id, stateOut)
+		}
+	}
+}
+
+func (e *voiceAssistantExtension) reconnect(tenEnv ten_runtime_go.TenEnv, sessionID string) {
+	e.closeSession(sessionID)
+	time.AfterFunc(time.Second, func() {
+		if _, err := e.sessionFor(tenEnv, sessionID); err != nil {
+			tenEnv.LogError(fmt.Sprintf("voice_assistant: reconnect for session %s failed: %v", sessionID, err))
+		}
+	})
+}
+
+func (e *voiceAssistantExtension) closeSession(sessionID string) {
+	e.mu.Lock()
+	session, ok := e.sessions[sessionID]
+	if ok {
+		delete(e.sessions, sessionID)
+	}
+	e.mu.Unlock()
+	if ok {
+		session.cancel()
+	}
+}
+
+func (e *voiceAssistantExtension) emitTranscript(tenEnv ten_runtime_go.TenEnv, sessionID string, stateOut *assistantpb.DialogStateOut) {
+	data, err := ten_runtime_go.NewData("transcript")
+	if err != nil {
+		tenEnv.LogError(fmt.Sprintf("voice_assistant: failed to create transcript data: %v", err))
+		return
+	}
+	data.SetPropertyString("session_id", sessionID)
+	data.SetPropertyString("text", stateOut.GetSupplementalDisplayText())
+	data.SetPropertyBool("is_final", stateOut.GetMicrophoneMode() != assistantpb.DialogStateOut_DIALOG_FOLLOW_ON)
+	tenEnv.SendData(data)
+}
+
+func (e *voiceAssistantExtension) emitReplyAudio(tenEnv ten_runtime_go.TenEnv, sessionID string, audio []byte) {
+	data, err := ten_runtime_go.NewData("reply_audio")
+	if err != nil {
+		tenEnv.LogError(fmt.Sprintf("voice_assistant: failed to create reply_audio data: %v", err))
+		return
+	}
+	data.SetPropertyString("session_id", sessionID)
+	data.SetPropertyBuf(audio)
+	tenEnv.SendData(data)
+}
+
+func registerVoiceAssistantExtension() {
+	ten_runtime_go.RegisterAddonAsExtension(
+		voiceAssistantExtensionName,
+		ten_runtime_go.NewDefaultExtensionAddon(newVoiceAssistantExtension),
+	)
+}